@@ -0,0 +1,109 @@
+package xcontainer
+
+// Cursor is a stable handle to an entry in an [OrderedMap], obtained
+// from [OrderedMap.Front], [OrderedMap.Back], or [OrderedMap.Cursor].
+// It can be advanced bidirectionally and used to mutate the map in
+// place.
+type Cursor[K comparable, V any] struct {
+	m    *OrderedMap[K, V]
+	node *List[entry[K, V]]
+}
+
+// Front returns a Cursor positioned at the first entry in m in
+// insertion order, or nil if m is empty.
+func (m *OrderedMap[K, V]) Front() *Cursor[K, V] {
+	if m.head == nil {
+		return nil
+	}
+	return &Cursor[K, V]{m: m, node: m.head}
+}
+
+// Back returns a Cursor positioned at the last entry in m in
+// insertion order, or nil if m is empty.
+func (m *OrderedMap[K, V]) Back() *Cursor[K, V] {
+	if m.head == nil {
+		return nil
+	}
+	return &Cursor[K, V]{m: m, node: m.head.Prev()}
+}
+
+// Cursor returns a Cursor positioned at key, and a boolean indicating
+// whether or not key was present in m.
+func (m *OrderedMap[K, V]) Cursor(key K) (*Cursor[K, V], bool) {
+	node, ok := m.m[key]
+	if !ok {
+		return nil, false
+	}
+	return &Cursor[K, V]{m: m, node: node}, true
+}
+
+// Key returns the key that c is positioned at.
+func (c *Cursor[K, V]) Key() K {
+	return c.node.Val.key
+}
+
+// Value returns the value that c is positioned at.
+func (c *Cursor[K, V]) Value() V {
+	return c.node.Val.val
+}
+
+// SetValue sets the value that c is positioned at to val.
+func (c *Cursor[K, V]) SetValue(val V) {
+	c.node.Val.val = val
+}
+
+// Next returns a Cursor positioned at the entry after c in insertion
+// order, or nil if c is at the last entry.
+func (c *Cursor[K, V]) Next() *Cursor[K, V] {
+	next := c.node.Next()
+	if next == c.m.head {
+		return nil
+	}
+	return &Cursor[K, V]{m: c.m, node: next}
+}
+
+// Prev returns a Cursor positioned at the entry before c in insertion
+// order, or nil if c is at the first entry.
+func (c *Cursor[K, V]) Prev() *Cursor[K, V] {
+	if c.node == c.m.head {
+		return nil
+	}
+	return &Cursor[K, V]{m: c.m, node: c.node.Prev()}
+}
+
+// Delete removes the entry that c is positioned at from the
+// underlying map. c is no longer valid after a call to Delete.
+func (c *Cursor[K, V]) Delete() {
+	c.m.Delete(c.node.Val.key)
+}
+
+// MoveToFront moves the entry that c is positioned at to the front of
+// the underlying map, making it the first entry in insertion order.
+func (c *Cursor[K, V]) MoveToFront() {
+	head := c.m.head
+	if c.node == head {
+		return
+	}
+
+	c.node.unlink()
+	c.node.linkBefore(head)
+	c.m.head = c.node
+}
+
+// MoveToBack moves the entry that c is positioned at to the back of
+// the underlying map, making it the last entry in insertion order.
+func (c *Cursor[K, V]) MoveToBack() {
+	head := c.m.head
+	if c.node == head.Prev() {
+		return
+	}
+
+	newHead := head
+	if c.node == head {
+		newHead = head.Next()
+	}
+
+	c.node.unlink()
+	c.node.linkBefore(newHead)
+	c.m.head = newHead
+}
@@ -0,0 +1,140 @@
+package xcontainer
+
+import "testing"
+
+func newCursorTestMap() *OrderedMap[string, int] {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	return m
+}
+
+func cursorTestKeys(m *OrderedMap[string, int]) []string {
+	var keys []string
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestFrontBack(t *testing.T) {
+	m := newCursorTestMap()
+	if k := m.Front().Key(); k != "a" {
+		t.Fatalf("Front().Key() = %v, want a", k)
+	}
+	if k := m.Back().Key(); k != "c" {
+		t.Fatalf("Back().Key() = %v, want c", k)
+	}
+
+	empty := NewOrderedMap[string, int]()
+	if c := empty.Front(); c != nil {
+		t.Fatalf("Front() on empty map = %v, want nil", c)
+	}
+	if c := empty.Back(); c != nil {
+		t.Fatalf("Back() on empty map = %v, want nil", c)
+	}
+}
+
+func TestCursorLookup(t *testing.T) {
+	m := newCursorTestMap()
+	c, ok := m.Cursor("b")
+	if !ok || c.Value() != 2 {
+		t.Fatalf("Cursor(b) = %v, %v, want 2, true", c, ok)
+	}
+
+	if _, ok := m.Cursor("z"); ok {
+		t.Fatalf("Cursor(z) ok = true, want false")
+	}
+}
+
+func TestCursorNextPrevAtEnds(t *testing.T) {
+	m := newCursorTestMap()
+
+	c := m.Front()
+	if c.Next().Next().Key() != "c" {
+		t.Fatalf("expected to reach c")
+	}
+	if c.Next().Next().Next() != nil {
+		t.Fatalf("Next() past the last entry should return nil")
+	}
+
+	c = m.Back()
+	if c.Prev().Prev().Key() != "a" {
+		t.Fatalf("expected to reach a")
+	}
+	if c.Prev().Prev().Prev() != nil {
+		t.Fatalf("Prev() past the first entry should return nil")
+	}
+}
+
+func TestCursorSetValue(t *testing.T) {
+	m := newCursorTestMap()
+	c, _ := m.Cursor("b")
+	c.SetValue(20)
+
+	if v, _ := m.Lookup("b"); v != 20 {
+		t.Fatalf("Lookup(b) = %v, want 20", v)
+	}
+}
+
+func TestCursorDelete(t *testing.T) {
+	m := newCursorTestMap()
+	c, _ := m.Cursor("b")
+	c.Delete()
+
+	if _, ok := m.Lookup("b"); ok {
+		t.Fatalf("b should have been deleted")
+	}
+	if m.Len() != 2 {
+		t.Fatalf("Len() = %v, want 2", m.Len())
+	}
+
+	if keys := cursorTestKeys(m); len(keys) != 2 || keys[0] != "a" || keys[1] != "c" {
+		t.Fatalf("keys = %v, want [a c]", keys)
+	}
+}
+
+func TestCursorMoveToFront(t *testing.T) {
+	m := newCursorTestMap()
+	c, _ := m.Cursor("c")
+	c.MoveToFront()
+
+	if keys := cursorTestKeys(m); len(keys) != 3 || keys[0] != "c" || keys[1] != "a" || keys[2] != "b" {
+		t.Fatalf("keys = %v, want [c a b]", keys)
+	}
+
+	// Moving the already-front entry to front is a no-op.
+	front, _ := m.Cursor("c")
+	front.MoveToFront()
+	if keys := cursorTestKeys(m); len(keys) != 3 || keys[0] != "c" {
+		t.Fatalf("keys = %v, want front c unchanged", keys)
+	}
+}
+
+func TestCursorMoveToBack(t *testing.T) {
+	m := newCursorTestMap()
+	c, _ := m.Cursor("a")
+	c.MoveToBack()
+
+	if keys := cursorTestKeys(m); len(keys) != 3 || keys[0] != "b" || keys[1] != "c" || keys[2] != "a" {
+		t.Fatalf("keys = %v, want [b c a]", keys)
+	}
+
+	// Moving the already-back entry to back is a no-op.
+	back, _ := m.Cursor("a")
+	back.MoveToBack()
+	if keys := cursorTestKeys(m); len(keys) != 3 || keys[2] != "a" {
+		t.Fatalf("keys = %v, want back a unchanged", keys)
+	}
+}
+
+func TestCursorMoveToBackFromFront(t *testing.T) {
+	m := newCursorTestMap()
+	c, _ := m.Cursor("a") // "a" is currently the front entry
+	c.MoveToBack()
+
+	if keys := cursorTestKeys(m); len(keys) != 3 || keys[0] != "b" || keys[1] != "c" || keys[2] != "a" {
+		t.Fatalf("keys = %v, want [b c a]", keys)
+	}
+}
@@ -0,0 +1,189 @@
+package xcontainer
+
+import "iter"
+
+// freqNode is one bucket in an LFUCache's frequency list, holding all
+// the keys that currently share the same access frequency.
+type freqNode[K comparable, V any] struct {
+	freq  int
+	items OrderedMap[K, V]
+}
+
+// LFUCache is a fixed-capacity cache that evicts the least frequently
+// used entry once more than Cap entries are present, breaking ties by
+// evicting the least recently used entry among those at the lowest
+// frequency. It is implemented as a doubly-linked list of frequency
+// buckets, ordered from least to most frequently used, each bucket
+// being an [OrderedMap] of the keys at that frequency.
+//
+// Unlike a built-in Go map, a zero-value LFUCache is not valid. Use
+// [NewLFUCache] to create one.
+type LFUCache[K comparable, V any] struct {
+	freqs *List[*freqNode[K, V]]
+	loc   map[K]*List[*freqNode[K, V]]
+	cap   int
+
+	// OnEvict, if non-nil, is called for each entry evicted to make
+	// room for a new one. It is not called by [LFUCache.Remove].
+	OnEvict func(K, V)
+}
+
+// NewLFUCache returns a new, empty LFUCache with the given capacity.
+// A negative capacity is clamped to zero.
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		cap: max(capacity, 0),
+		loc: make(map[K]*List[*freqNode[K, V]]),
+	}
+}
+
+// firstBucket returns the freq-1 bucket, creating it as the new head
+// of the frequency list if it doesn't already exist.
+func (c *LFUCache[K, V]) firstBucket() *List[*freqNode[K, V]] {
+	if c.freqs != nil && c.freqs.Val.freq == 1 {
+		return c.freqs
+	}
+
+	head := c.freqs
+	c.freqs = head.InsertBefore(&freqNode[K, V]{freq: 1})
+	if head != nil {
+		c.freqs = c.freqs.Prev()
+	}
+	return c.freqs
+}
+
+// nextBucket returns the bucket for node's frequency plus one,
+// creating it immediately after node if it doesn't already exist.
+func (c *LFUCache[K, V]) nextBucket(node *List[*freqNode[K, V]]) *List[*freqNode[K, V]] {
+	freq := node.Val.freq + 1
+	if next := node.Next(); next.Val.freq == freq {
+		return next
+	}
+	return node.InsertAfter(&freqNode[K, V]{freq: freq}).Next()
+}
+
+// dropIfEmpty removes node from the frequency list if its bucket has
+// no entries left in it.
+func (c *LFUCache[K, V]) dropIfEmpty(node *List[*freqNode[K, V]]) {
+	if node.Val.items.Len() > 0 {
+		return
+	}
+
+	if node == c.freqs {
+		if next := node.Next(); next != node {
+			c.freqs = next
+		} else {
+			c.freqs = nil
+		}
+	}
+	node.Remove()
+}
+
+// Get returns the value associated with key, promoting it to the
+// next-higher frequency bucket, and a boolean indicating if there was
+// one or not.
+func (c *LFUCache[K, V]) Get(key K) (val V, ok bool) {
+	node, ok := c.loc[key]
+	if !ok {
+		return val, false
+	}
+
+	val, _ = node.Val.items.Lookup(key)
+	node.Val.items.Delete(key)
+
+	next := c.nextBucket(node)
+	next.Val.items.Set(key, val)
+	c.loc[key] = next
+
+	c.dropIfEmpty(node)
+	return val, true
+}
+
+// Put sets the provided key to val. A new key starts in the
+// frequency-1 bucket; an existing key keeps its current frequency.
+// Entries are evicted from the lowest-frequency bucket until Len no
+// longer exceeds Cap.
+func (c *LFUCache[K, V]) Put(key K, val V) {
+	if node, ok := c.loc[key]; ok {
+		node.Val.items.Set(key, val)
+		return
+	}
+
+	bucket := c.firstBucket()
+	bucket.Val.items.Set(key, val)
+	c.loc[key] = bucket
+
+	c.evict()
+}
+
+// Peek returns the value associated with key without affecting its
+// frequency, and a boolean indicating if there was one or not.
+func (c *LFUCache[K, V]) Peek(key K) (val V, ok bool) {
+	node, ok := c.loc[key]
+	if !ok {
+		return val, false
+	}
+	return node.Val.items.Lookup(key)
+}
+
+// Remove removes the value associated with key. If no such value
+// exists, it does nothing. Unlike eviction, Remove does not invoke
+// OnEvict.
+func (c *LFUCache[K, V]) Remove(key K) {
+	node, ok := c.loc[key]
+	if !ok {
+		return
+	}
+
+	node.Val.items.Delete(key)
+	delete(c.loc, key)
+	c.dropIfEmpty(node)
+}
+
+// Len returns the number of entries currently in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.loc)
+}
+
+// Cap returns the maximum number of entries the cache will hold.
+func (c *LFUCache[K, V]) Cap() int {
+	return c.cap
+}
+
+// Resize changes the capacity of the cache to n, evicting
+// lowest-frequency entries if necessary. A negative n is clamped to
+// zero.
+func (c *LFUCache[K, V]) Resize(n int) {
+	c.cap = max(n, 0)
+	c.evict()
+}
+
+// All returns an iter.Seq2 that yields key value pairs in eviction
+// order: lowest frequency to highest, and least to most recently used
+// within a frequency.
+func (c *LFUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := range c.freqs.All() {
+			for k, v := range node.Val.items.All() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *LFUCache[K, V]) evict() {
+	for len(c.loc) > c.cap {
+		front := c.freqs.Val.items.Front()
+		key, val := front.Key(), front.Value()
+		front.Delete()
+		delete(c.loc, key)
+
+		c.dropIfEmpty(c.freqs)
+
+		if c.OnEvict != nil {
+			c.OnEvict(key, val)
+		}
+	}
+}
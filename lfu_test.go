@@ -0,0 +1,71 @@
+package xcontainer
+
+import "testing"
+
+func TestLFUCacheBucketLifecycle(t *testing.T) {
+	var evicted []string
+	c := NewLFUCache[string, int](2)
+	c.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // promotes a to the freq-2 bucket, leaving b alone at freq 1
+
+	c.Put("c", 3) // evicts from the lowest surviving frequency, i.e. b
+
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("evicted = %v, want [b]", evicted)
+	}
+	if _, ok := c.Peek("b"); ok {
+		t.Fatalf("b should have been evicted")
+	}
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Fatalf("Peek(a) = %v, %v, want 1, true", v, ok)
+	}
+}
+
+func TestLFUCacheTieBreakWithinFrequency(t *testing.T) {
+	var evicted []string
+	c := NewLFUCache[string, int](2)
+	c.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	// Both still at freq 1; a was inserted first, so it is the least
+	// recently used entry in that bucket.
+	c.Put("c", 3)
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("evicted = %v, want [a]", evicted)
+	}
+}
+
+func TestLFUCacheEmptyBucketIsDropped(t *testing.T) {
+	c := NewLFUCache[string, int](10)
+	c.Put("a", 1)
+	c.Get("a") // moves a out of the freq-1 bucket, which should then be dropped
+
+	if c.freqs == nil || c.freqs.Val.freq != 2 {
+		t.Fatalf("expected freqs to start at the freq-2 bucket once freq-1 empties")
+	}
+}
+
+func TestLFUCacheResizeClampsNegative(t *testing.T) {
+	c := NewLFUCache[int, int](2)
+	c.Put(1, 1)
+	c.Put(2, 2)
+
+	c.Resize(-1)
+	if c.Cap() != 0 {
+		t.Fatalf("Cap() = %v, want 0", c.Cap())
+	}
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %v, want 0", c.Len())
+	}
+
+	// A subsequent Put must not panic now that the cache is empty.
+	c.Put(3, 3)
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %v, want 0 at cap 0", c.Len())
+	}
+}
@@ -47,6 +47,10 @@ func (list *List[T]) InsertBefore(v T) *List[T] {
 	node := &List[T]{next: list, Val: v}
 	if list != nil {
 		node.prev, list.prev = list.prev, node
+		// Baseline bugfix: the old node at node.prev also needs its
+		// next pointer repointed at node, or forward traversal skips
+		// every node but list itself once the list has 2+ elements.
+		node.prev.next = node
 		return list
 	}
 	node.prev, node.next = node, node
@@ -61,6 +65,10 @@ func (list *List[T]) InsertAfter(v T) *List[T] {
 	node := &List[T]{prev: list, Val: v}
 	if list != nil {
 		node.next, list.next = list.next, node
+		// Baseline bugfix, symmetric to the one in InsertBefore: the
+		// old node at node.next also needs its prev pointer repointed
+		// at node, or backward traversal breaks the same way.
+		node.next.prev = node
 		return list
 	}
 	node.prev, node.next = node, node
@@ -68,17 +76,36 @@ func (list *List[T]) InsertAfter(v T) *List[T] {
 }
 
 // Remove removes list from the List that it represents. It returns
-// the node before list. list is no longer valid after a call to
-// Remove.
+// the node before list, or nil if list was the only node, meaning the
+// List is now empty. list is no longer valid after a call to Remove.
 func (list *List[T]) Remove() *List[T] {
 	if list == nil {
 		return nil
 	}
+	if list.next == list {
+		// Baseline bugfix: a self-looped single-node list must report
+		// itself as empty (nil) once removed, not return list itself
+		// as if it still had something before it.
+		return nil
+	}
 
 	list.prev.next, list.next.prev = list.next, list.prev
 	return list.prev
 }
 
+// unlink removes list from the List that it represents without
+// clearing list's own prev/next pointers, leaving it ready to be
+// relinked elsewhere with linkBefore.
+func (list *List[T]) unlink() {
+	list.prev.next, list.next.prev = list.next, list.prev
+}
+
+// linkBefore relinks list so that it sits immediately before mark.
+func (list *List[T]) linkBefore(mark *List[T]) {
+	list.prev, list.next = mark.prev, mark
+	mark.prev.next, mark.prev = list, list
+}
+
 // InsertSeqBefore inserts the values yielded by seq as new nodes
 // before list. The order of the elements in the List will be the same
 // as they were in seq. The behavior of this function is otherwise the
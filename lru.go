@@ -0,0 +1,99 @@
+package xcontainer
+
+import "iter"
+
+// LRUCache is a fixed-capacity cache that evicts the least recently
+// used entry once more than Cap entries are present. It is
+// implemented on top of [OrderedMap], using insertion order as
+// recency order.
+//
+// Unlike a built-in Go map, a zero-value LRUCache is not valid. Use
+// [NewLRUCache] to create one.
+type LRUCache[K comparable, V any] struct {
+	m   OrderedMap[K, V]
+	cap int
+
+	// OnEvict, if non-nil, is called for each entry evicted to make
+	// room for a new one. It is not called by [LRUCache.Remove].
+	OnEvict func(K, V)
+}
+
+// NewLRUCache returns a new, empty LRUCache with the given capacity.
+// A negative capacity is clamped to zero.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return &LRUCache[K, V]{cap: max(capacity, 0)}
+}
+
+// Get returns the value associated with key, promoting it to most
+// recently used, and a boolean indicating if there was one or not.
+func (c *LRUCache[K, V]) Get(key K) (val V, ok bool) {
+	cur, ok := c.m.Cursor(key)
+	if !ok {
+		return val, false
+	}
+
+	cur.MoveToBack()
+	return cur.Value(), true
+}
+
+// Put sets the provided key to val, promoting it to most recently
+// used, and evicts the least recently used entries until Len no
+// longer exceeds Cap.
+func (c *LRUCache[K, V]) Put(key K, val V) {
+	if cur, ok := c.m.Cursor(key); ok {
+		cur.SetValue(val)
+		cur.MoveToBack()
+	} else {
+		c.m.Set(key, val)
+	}
+	c.evict()
+}
+
+// Peek returns the value associated with key without affecting its
+// recency, and a boolean indicating if there was one or not.
+func (c *LRUCache[K, V]) Peek(key K) (val V, ok bool) {
+	return c.m.Lookup(key)
+}
+
+// Remove removes the value associated with key. If no such value
+// exists, it does nothing. Unlike eviction, Remove does not invoke
+// OnEvict.
+func (c *LRUCache[K, V]) Remove(key K) {
+	c.m.Delete(key)
+}
+
+// Len returns the number of entries currently in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	return c.m.Len()
+}
+
+// Cap returns the maximum number of entries the cache will hold.
+func (c *LRUCache[K, V]) Cap() int {
+	return c.cap
+}
+
+// Resize changes the capacity of the cache to n, evicting the least
+// recently used entries if necessary. A negative n is clamped to
+// zero.
+func (c *LRUCache[K, V]) Resize(n int) {
+	c.cap = max(n, 0)
+	c.evict()
+}
+
+// All returns an iter.Seq2 that yields key value pairs in eviction
+// order, from least to most recently used.
+func (c *LRUCache[K, V]) All() iter.Seq2[K, V] {
+	return c.m.All()
+}
+
+func (c *LRUCache[K, V]) evict() {
+	for c.m.Len() > c.cap {
+		front := c.m.Front()
+		key, val := front.Key(), front.Value()
+		front.Delete()
+
+		if c.OnEvict != nil {
+			c.OnEvict(key, val)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package xcontainer
+
+import "testing"
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	c := NewLRUCache[string, int](2)
+	c.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // a is now most recently used; b is least recently used
+
+	c.Put("c", 3) // evicts b
+
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("evicted = %v, want [b]", evicted)
+	}
+	if _, ok := c.Peek("b"); ok {
+		t.Fatalf("b should have been evicted")
+	}
+}
+
+func TestLRUCacheResizeClampsNegative(t *testing.T) {
+	c := NewLRUCache[int, int](2)
+	c.Put(1, 1)
+	c.Put(2, 2)
+
+	c.Resize(-1)
+	if c.Cap() != 0 {
+		t.Fatalf("Cap() = %v, want 0", c.Cap())
+	}
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %v, want 0", c.Len())
+	}
+
+	// A subsequent Put must not panic now that the cache is empty.
+	c.Put(3, 3)
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %v, want 0 at cap 0", c.Len())
+	}
+}
@@ -0,0 +1,145 @@
+package xcontainer
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes m as a JSON object with its keys in insertion
+// order, unlike Go's built-in map type, which encoding/json always
+// sorts by key. K must be string or implement
+// [encoding.TextMarshaler].
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		keyJSON, err := marshalJSONKey(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+
+		valJSON, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(valJSON)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func marshalJSONKey[K comparable](key K) ([]byte, error) {
+	switch key := any(key).(type) {
+	case string:
+		return json.Marshal(key)
+	case encoding.TextMarshaler:
+		text, err := key.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(text))
+	default:
+		return nil, fmt.Errorf("xcontainer: key type %T does not implement encoding.TextMarshaler", key)
+	}
+}
+
+// UnmarshalJSON decodes a JSON object into m, recording keys in the
+// order they appear in data rather than the order produced by Go's
+// built-in map type. K must be string or implement
+// [encoding.TextUnmarshaler].
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("xcontainer: expected a JSON object, got %v", tok)
+	}
+
+	m.Clear()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		keyText, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("xcontainer: expected a JSON object key, got %v", tok)
+		}
+
+		key, err := unmarshalJSONKey[K](keyText)
+		if err != nil {
+			return err
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+
+		m.Set(key, val)
+	}
+
+	_, err = dec.Token() // consume the closing '}'
+	return err
+}
+
+func unmarshalJSONKey[K comparable](text string) (K, error) {
+	var key K
+	switch dst := any(&key).(type) {
+	case *string:
+		*dst = text
+	case encoding.TextUnmarshaler:
+		if err := dst.UnmarshalText([]byte(text)); err != nil {
+			return key, err
+		}
+	default:
+		return key, fmt.Errorf("xcontainer: key type %T does not implement encoding.TextUnmarshaler", key)
+	}
+	return key, nil
+}
+
+// MarshalBinary encodes m as a gob-encoded slice of [Pair], preserving
+// insertion order. K and V must be encodable by encoding/gob.
+func (m *OrderedMap[K, V]) MarshalBinary() ([]byte, error) {
+	pairs := make([]Pair[K, V], 0, m.Len())
+	for k, v := range m.All() {
+		pairs = append(pairs, Pair[K, V]{Key: k, Val: v})
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(pairs); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes data produced by MarshalBinary into m,
+// replacing its contents and restoring insertion order.
+func (m *OrderedMap[K, V]) UnmarshalBinary(data []byte) error {
+	var pairs []Pair[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&pairs); err != nil {
+		return err
+	}
+
+	m.Clear()
+	for _, p := range pairs {
+		m.Set(p.Key, p.Val)
+	}
+	return nil
+}
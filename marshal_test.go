@@ -0,0 +1,126 @@
+package xcontainer
+
+import (
+	"strconv"
+	"testing"
+)
+
+type marshalTestIntKey int
+
+func (k marshalTestIntKey) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(int(k))), nil
+}
+
+func (k *marshalTestIntKey) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(string(text))
+	if err != nil {
+		return err
+	}
+	*k = marshalTestIntKey(n)
+	return nil
+}
+
+type marshalTestUnsupportedKey struct{}
+
+func TestMarshalJSONOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	data, err := m.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if want := `{"c":3,"a":1,"b":2}`; string(data) != want {
+		t.Fatalf("MarshalJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestUnmarshalJSONOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	if err := m.UnmarshalJSON([]byte(`{"c":3,"a":1,"b":2}`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	var keys []string
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	want := []string{"c", "a", "b"}
+	if len(keys) != len(want) {
+		t.Fatalf("keys = %v, want %v", keys, want)
+	}
+	for i, k := range want {
+		if keys[i] != k {
+			t.Fatalf("keys = %v, want %v", keys, want)
+		}
+	}
+}
+
+func TestMarshalJSONTextMarshalerKey(t *testing.T) {
+	m := NewOrderedMap[marshalTestIntKey, string]()
+	m.Set(2, "two")
+	m.Set(1, "one")
+
+	data, err := m.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if want := `{"2":"two","1":"one"}`; string(data) != want {
+		t.Fatalf("MarshalJSON() = %s, want %s", data, want)
+	}
+
+	got := NewOrderedMap[marshalTestIntKey, string]()
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if v, ok := got.Lookup(1); !ok || v != "one" {
+		t.Fatalf("Lookup(1) = %v, %v, want one, true", v, ok)
+	}
+}
+
+func TestMarshalJSONUnsupportedKeyType(t *testing.T) {
+	m := NewOrderedMap[marshalTestUnsupportedKey, int]()
+	m.Set(marshalTestUnsupportedKey{}, 1)
+
+	if _, err := m.MarshalJSON(); err == nil {
+		t.Fatalf("MarshalJSON() error = nil, want non-nil for unsupported key type")
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	got := NewOrderedMap[string, int]()
+	got.Set("z", 99) // should be wiped out by UnmarshalBinary's Clear
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	if got.Len() != 3 {
+		t.Fatalf("Len() = %v, want 3", got.Len())
+	}
+
+	var keys []string
+	for k := range got.Keys() {
+		keys = append(keys, k)
+	}
+	want := []string{"c", "a", "b"}
+	if len(keys) != len(want) {
+		t.Fatalf("keys = %v, want %v", keys, want)
+	}
+	for i, k := range want {
+		if keys[i] != k {
+			t.Fatalf("keys = %v, want %v", keys, want)
+		}
+	}
+}
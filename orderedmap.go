@@ -21,6 +21,67 @@ func enter[K comparable, V any](key K, val V) entry[K, V] {
 type OrderedMap[K comparable, V any] struct {
 	m    map[K]*List[entry[K, V]]
 	head *List[entry[K, V]]
+	cap  int
+}
+
+// Pair is a key value pair, used by [WithInitialPairs] to seed an
+// OrderedMap.
+type Pair[K, V any] struct {
+	Key K
+	Val V
+}
+
+// OrderedMapOption configures an OrderedMap constructed by
+// [NewOrderedMap].
+type OrderedMapOption[K comparable, V any] func(*OrderedMap[K, V])
+
+// WithCapacity hints that the map will hold around n entries, sizing
+// the underlying map accordingly to avoid rehashing during bulk
+// inserts. It has no effect if the map has already been initialized,
+// so it should be the first option passed to [NewOrderedMap] if used
+// alongside [WithInitialPairs].
+//
+// Neither K nor V appears in n's type, so Go has nothing to infer them
+// from at the call site; WithCapacity needs explicit type arguments,
+// e.g. NewOrderedMap[string, int](WithCapacity[string, int](16)).
+func WithCapacity[K comparable, V any](n int) OrderedMapOption[K, V] {
+	return func(m *OrderedMap[K, V]) {
+		m.cap = n
+	}
+}
+
+// WithInitialPairs seeds the map with pairs, in order. Duplicate keys
+// in pairs are not treated as an error: each later occurrence
+// overwrites the value of the earlier one in place, so the entry ends
+// up positioned at its first occurrence but holding its last value,
+// the same as repeated calls to [OrderedMap.Set] would produce.
+func WithInitialPairs[K comparable, V any](pairs ...Pair[K, V]) OrderedMapOption[K, V] {
+	return func(m *OrderedMap[K, V]) {
+		for _, p := range pairs {
+			m.Set(p.Key, p.Val)
+		}
+	}
+}
+
+// NewOrderedMap returns a new, empty OrderedMap configured by opts.
+func NewOrderedMap[K comparable, V any](opts ...OrderedMapOption[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// NewOrderedMapFromSeq returns a new OrderedMap containing the key
+// value pairs yielded by seq, in order. As with [WithInitialPairs],
+// duplicate keys in seq overwrite in place rather than producing
+// duplicate entries.
+func NewOrderedMapFromSeq[K comparable, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	for k, v := range seq {
+		m.Set(k, v)
+	}
+	return m
 }
 
 func (m *OrderedMap[K, V]) init() {
@@ -28,7 +89,7 @@ func (m *OrderedMap[K, V]) init() {
 		return
 	}
 
-	m.m = make(map[K]*List[entry[K, V]])
+	m.m = make(map[K]*List[entry[K, V]], m.cap)
 }
 
 // Set sets the provided key to val in m.
@@ -77,6 +138,11 @@ func (m *OrderedMap[K, V]) Clear() {
 	m.head = nil
 }
 
+// Len returns the number of entries in m.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.m)
+}
+
 // All returns an iter.Seq that yields key value pairs in insertion
 // order.
 func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
@@ -0,0 +1,68 @@
+package xcontainer
+
+import "testing"
+
+func TestWithInitialPairsDuplicateKeys(t *testing.T) {
+	m := NewOrderedMap(WithInitialPairs(
+		Pair[string, int]{Key: "a", Val: 1},
+		Pair[string, int]{Key: "b", Val: 2},
+		Pair[string, int]{Key: "a", Val: 3},
+	))
+
+	if m.Len() != 2 {
+		t.Fatalf("Len() = %v, want 2", m.Len())
+	}
+
+	var keys []string
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("keys = %v, want [a b]", keys)
+	}
+
+	v, ok := m.Lookup("a")
+	if !ok || v != 3 {
+		t.Fatalf("Lookup(a) = %v, %v, want 3, true", v, ok)
+	}
+}
+
+func TestWithCapacityExplicitInstantiation(t *testing.T) {
+	// K and V can't be inferred from WithCapacity's own argument, so
+	// it must be instantiated explicitly.
+	m := NewOrderedMap[string, int](WithCapacity[string, int](16))
+	m.Set("a", 1)
+
+	if v, ok := m.Lookup("a"); !ok || v != 1 {
+		t.Fatalf("Lookup(a) = %v, %v, want 1, true", v, ok)
+	}
+}
+
+func TestNewOrderedMapFromSeqDuplicateKeys(t *testing.T) {
+	pairs := []Pair[string, int]{{Key: "a", Val: 1}, {Key: "b", Val: 2}, {Key: "a", Val: 3}}
+	seq := func(yield func(string, int) bool) {
+		for _, p := range pairs {
+			if !yield(p.Key, p.Val) {
+				return
+			}
+		}
+	}
+
+	m := NewOrderedMapFromSeq(seq)
+	if m.Len() != 2 {
+		t.Fatalf("Len() = %v, want 2", m.Len())
+	}
+
+	var keys []string
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("keys = %v, want [a b]", keys)
+	}
+
+	v, ok := m.Lookup("a")
+	if !ok || v != 3 {
+		t.Fatalf("Lookup(a) = %v, %v, want 3, true", v, ok)
+	}
+}
@@ -0,0 +1,156 @@
+package xcontainer
+
+import (
+	"iter"
+	"slices"
+)
+
+// ShardedOrderedMap partitions its entries across a fixed number of
+// [SyncOrderedMap] shards, selected by hashing the key. This spreads
+// lock contention across shards instead of a single mutex, at the
+// cost of no longer maintaining a single global insertion order:
+// insertion order is only preserved within a shard.
+type ShardedOrderedMap[K comparable, V any] struct {
+	shards []*SyncOrderedMap[K, V]
+	hash   func(K) uint64
+	less   func(a, b K) int
+}
+
+// ShardedOrderedMapOption configures a ShardedOrderedMap constructed
+// by [NewShardedOrderedMap].
+type ShardedOrderedMapOption[K comparable, V any] func(*ShardedOrderedMap[K, V])
+
+// WithMergeOrder sets the comparison function used by All to merge
+// entries across shards, in the same sense as [cmp.Compare]. If it is
+// not provided, All yields entries shard by shard instead, with no
+// particular ordering between shards.
+//
+// V does not appear in less's type, so Go has nothing to infer it
+// from at the call site; WithMergeOrder needs explicit type
+// arguments, e.g.
+// NewShardedOrderedMap[string, int](n, hash, WithMergeOrder[string, int](cmp.Compare[string])).
+func WithMergeOrder[K comparable, V any](less func(a, b K) int) ShardedOrderedMapOption[K, V] {
+	return func(s *ShardedOrderedMap[K, V]) {
+		s.less = less
+	}
+}
+
+// NewShardedOrderedMap returns a new, empty ShardedOrderedMap split
+// across n shards, using hash to assign keys to shards. It panics if
+// n is not positive.
+func NewShardedOrderedMap[K comparable, V any](n int, hash func(K) uint64, opts ...ShardedOrderedMapOption[K, V]) *ShardedOrderedMap[K, V] {
+	if n <= 0 {
+		panic("xcontainer: NewShardedOrderedMap: n must be positive")
+	}
+
+	s := &ShardedOrderedMap[K, V]{
+		shards: make([]*SyncOrderedMap[K, V], n),
+		hash:   hash,
+	}
+	for i := range s.shards {
+		s.shards[i] = new(SyncOrderedMap[K, V])
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *ShardedOrderedMap[K, V]) shardFor(key K) *SyncOrderedMap[K, V] {
+	return s.shards[s.hash(key)%uint64(len(s.shards))]
+}
+
+// Set sets the provided key to val in s.
+func (s *ShardedOrderedMap[K, V]) Set(key K, val V) {
+	s.shardFor(key).Set(key, val)
+}
+
+// Lookup returns the value associated with the key and a boolean
+// indicating if there was one or not.
+func (s *ShardedOrderedMap[K, V]) Lookup(key K) (val V, ok bool) {
+	return s.shardFor(key).Lookup(key)
+}
+
+// Delete removes the value associated with key from s. If no such
+// value exists, it does nothing.
+func (s *ShardedOrderedMap[K, V]) Delete(key K) {
+	s.shardFor(key).Delete(key)
+}
+
+// Clear deletes everything from s, resulting in an empty map.
+func (s *ShardedOrderedMap[K, V]) Clear() {
+	for _, shard := range s.shards {
+		shard.Clear()
+	}
+}
+
+// Len returns the number of entries in s, across all shards.
+func (s *ShardedOrderedMap[K, V]) Len() int {
+	n := 0
+	for _, shard := range s.shards {
+		n += shard.Len()
+	}
+	return n
+}
+
+// All returns an iter.Seq2 over snapshots of s's shards. If s was
+// constructed with [WithMergeOrder], every shard is snapshotted when
+// iteration begins and the pairs are merged into a single sequence
+// ordered accordingly; otherwise each shard is snapshotted when
+// iteration reaches it and its pairs are yielded shard by shard, with
+// insertion order preserved within each shard but no particular
+// ordering between shards. The shards are snapshotted one at a time,
+// not together, so the pairs need not reflect s at a single moment.
+func (s *ShardedOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	if s.less == nil {
+		return func(yield func(K, V) bool) {
+			for _, shard := range s.shards {
+				for k, v := range shard.All() {
+					if !yield(k, v) {
+						return
+					}
+				}
+			}
+		}
+	}
+
+	return func(yield func(K, V) bool) {
+		var pairs []Pair[K, V]
+		for _, shard := range s.shards {
+			pairs = append(pairs, shard.snapshot()...)
+		}
+		slices.SortFunc(pairs, func(a, b Pair[K, V]) int {
+			return s.less(a.Key, b.Key)
+		})
+
+		for _, p := range pairs {
+			if !yield(p.Key, p.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over s's keys, snapshotted and ordered as
+// in All.
+func (s *ShardedOrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k, _ := range s.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iter.Seq over s's values, snapshotted and ordered
+// as in All.
+func (s *ShardedOrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range s.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package xcontainer
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+func fnv1a(s string) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+
+	h := uint64(offset)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime
+	}
+	return h
+}
+
+// BenchmarkSyncOrderedMapSet measures Set throughput under concurrent
+// writers sharing a single SyncOrderedMap, i.e. a single mutex.
+func BenchmarkSyncOrderedMapSet(b *testing.B) {
+	var m SyncOrderedMap[string, int]
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Set(strconv.Itoa(i), i)
+			i++
+		}
+	})
+}
+
+// BenchmarkShardedOrderedMapSet measures Set throughput under the same
+// concurrent writers as BenchmarkSyncOrderedMapSet, but spread across
+// a ShardedOrderedMap, showing the contention reduction from
+// partitioning the lock.
+func BenchmarkShardedOrderedMapSet(b *testing.B) {
+	s := NewShardedOrderedMap[string, int](runtime.GOMAXPROCS(0), fnv1a)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.Set(strconv.Itoa(i), i)
+			i++
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package xcontainer
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestWithMergeOrderExplicitInstantiation(t *testing.T) {
+	// K is inferable from less's type, but V is not, so WithMergeOrder
+	// needs explicit type arguments here.
+	s := NewShardedOrderedMap[string, int](4, fnv1a, WithMergeOrder[string, int](cmp.Compare[string]))
+
+	s.Set("delta", 4)
+	s.Set("alpha", 1)
+	s.Set("charlie", 3)
+	s.Set("bravo", 2)
+
+	var got []string
+	for k := range s.Keys() {
+		got = append(got, k)
+	}
+
+	want := []string{"alpha", "bravo", "charlie", "delta"}
+	if len(got) != len(want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+	for i, k := range want {
+		if got[i] != k {
+			t.Fatalf("keys = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package xcontainer
+
+import (
+	"cmp"
+	"iter"
+)
+
+// snode is a node in the AVL tree backing a SortedMap.
+type snode[K, V any] struct {
+	key         K
+	val         V
+	left, right *snode[K, V]
+	height      int
+}
+
+func (n *snode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *snode[K, V]) balance() int {
+	if n == nil {
+		return 0
+	}
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+func (n *snode[K, V]) fix() *snode[K, V] {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+
+	switch bal := n.balance(); {
+	case bal > 1 && n.left.balance() >= 0:
+		return n.rotateRight()
+	case bal > 1:
+		n.left = n.left.rotateLeft()
+		return n.rotateRight()
+	case bal < -1 && n.right.balance() <= 0:
+		return n.rotateLeft()
+	case bal < -1:
+		n.right = n.right.rotateRight()
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+func (n *snode[K, V]) rotateLeft() *snode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	r.height = 1 + max(r.left.getHeight(), r.right.getHeight())
+	return r
+}
+
+func (n *snode[K, V]) rotateRight() *snode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	l.height = 1 + max(l.left.getHeight(), l.right.getHeight())
+	return l
+}
+
+// SortedMap is similar to Go's built-in map type, but keeps entries in
+// key-sorted order rather than insertion order. It is implemented as
+// a self-balancing (AVL) binary search tree.
+//
+// Unlike a built-in Go map, a zero-value SortedMap is not valid. Use
+// [NewSortedMap] or [NewSortedMapFunc] to create one.
+type SortedMap[K, V any] struct {
+	root *snode[K, V]
+	cmp  func(K, K) int
+	len  int
+}
+
+// NewSortedMap returns a new, empty SortedMap ordered using
+// [cmp.Compare].
+func NewSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return NewSortedMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewSortedMapFunc returns a new, empty SortedMap ordered using cmp,
+// which must return a negative number, zero, or a positive number
+// depending on whether its first argument is less than, equal to, or
+// greater than its second, matching the semantics of [cmp.Compare].
+func NewSortedMapFunc[K, V any](cmp func(K, K) int) *SortedMap[K, V] {
+	return &SortedMap[K, V]{cmp: cmp}
+}
+
+func (m *SortedMap[K, V]) insert(n *snode[K, V], key K, val V) (*snode[K, V], bool) {
+	if n == nil {
+		m.len++
+		return &snode[K, V]{key: key, val: val, height: 1}, true
+	}
+
+	var ok bool
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left, ok = m.insert(n.left, key, val)
+	case c > 0:
+		n.right, ok = m.insert(n.right, key, val)
+	default:
+		n.val = val
+		return n, false
+	}
+	return n.fix(), ok
+}
+
+// Set sets the provided key to val in m.
+func (m *SortedMap[K, V]) Set(key K, val V) {
+	m.root, _ = m.insert(m.root, key, val)
+}
+
+func (m *SortedMap[K, V]) find(key K) *snode[K, V] {
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Lookup returns the value associated with the key and a boolean
+// indicating if there was one or not.
+func (m *SortedMap[K, V]) Lookup(key K) (val V, ok bool) {
+	n := m.find(key)
+	if n == nil {
+		return val, false
+	}
+	return n.val, true
+}
+
+func minNode[K, V any](n *snode[K, V]) *snode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// detachMin removes and returns the minimum node of the subtree
+// rooted at n, along with the resulting subtree root.
+func (m *SortedMap[K, V]) detachMin(n *snode[K, V]) (_ *snode[K, V], min *snode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	n.left, min = m.detachMin(n.left)
+	return n.fix(), min
+}
+
+func (m *SortedMap[K, V]) remove(n *snode[K, V], key K) (*snode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var ok bool
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left, ok = m.remove(n.left, key)
+	case c > 0:
+		n.right, ok = m.remove(n.right, key)
+	default:
+		ok = true
+
+		switch {
+		case n.left == nil:
+			return n.right, ok
+		case n.right == nil:
+			return n.left, ok
+		default:
+			var succ *snode[K, V]
+			n.right, succ = m.detachMin(n.right)
+			n.key, n.val = succ.key, succ.val
+		}
+	}
+	if n == nil {
+		return nil, ok
+	}
+	return n.fix(), ok
+}
+
+// Delete removes the value associated with key from the map. If no
+// such value exists, it does nothing.
+func (m *SortedMap[K, V]) Delete(key K) {
+	var ok bool
+	m.root, ok = m.remove(m.root, key)
+	if ok {
+		m.len--
+	}
+}
+
+// Clear deletes everything from m, resulting in an empty map.
+func (m *SortedMap[K, V]) Clear() {
+	m.root = nil
+	m.len = 0
+}
+
+// Len returns the number of entries in m.
+func (m *SortedMap[K, V]) Len() int {
+	return m.len
+}
+
+// Min returns the smallest key in m and its associated value, along
+// with a boolean indicating whether or not m is non-empty.
+func (m *SortedMap[K, V]) Min() (key K, val V, ok bool) {
+	if m.root == nil {
+		return key, val, false
+	}
+	n := minNode(m.root)
+	return n.key, n.val, true
+}
+
+func maxNode[K, V any](n *snode[K, V]) *snode[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// Max returns the largest key in m and its associated value, along
+// with a boolean indicating whether or not m is non-empty.
+func (m *SortedMap[K, V]) Max() (key K, val V, ok bool) {
+	if m.root == nil {
+		return key, val, false
+	}
+	n := maxNode(m.root)
+	return n.key, n.val, true
+}
+
+// All returns an iter.Seq2 that yields key value pairs in ascending
+// key order.
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		stack := make([]*snode[K, V], 0, m.root.getHeight())
+		n := m.root
+		for n != nil || len(stack) > 0 {
+			for n != nil {
+				stack = append(stack, n)
+				n = n.left
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(n.key, n.val) {
+				return
+			}
+			n = n.right
+		}
+	}
+}
+
+// Backward returns an iter.Seq2 that yields key value pairs in
+// descending key order.
+func (m *SortedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		stack := make([]*snode[K, V], 0, m.root.getHeight())
+		n := m.root
+		for n != nil || len(stack) > 0 {
+			for n != nil {
+				stack = append(stack, n)
+				n = n.right
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(n.key, n.val) {
+				return
+			}
+			n = n.left
+		}
+	}
+}
+
+// Range returns an iter.Seq2 that yields key value pairs for keys k
+// such that lo <= k < hi, in ascending order.
+func (m *SortedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *snode[K, V]) bool
+		walk = func(n *snode[K, V]) bool {
+			if n == nil {
+				return true
+			}
+			if m.cmp(lo, n.key) < 0 {
+				if !walk(n.left) {
+					return false
+				}
+			}
+			if m.cmp(lo, n.key) <= 0 && m.cmp(n.key, hi) < 0 {
+				if !yield(n.key, n.val) {
+					return false
+				}
+			}
+			if m.cmp(n.key, hi) < 0 {
+				if !walk(n.right) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(m.root)
+	}
+}
+
+// Keys returns an iter.Seq that yields keys in ascending order.
+func (m *SortedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iter.Seq that yields values in ascending key
+// order.
+func (m *SortedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
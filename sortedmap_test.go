@@ -0,0 +1,197 @@
+package xcontainer
+
+import "testing"
+
+// checkBalanced walks the AVL tree rooted at n, failing t if any
+// node's balance factor or cached height is wrong, and returns n's
+// height.
+func checkBalanced[K, V any](t *testing.T, n *snode[K, V]) int {
+	t.Helper()
+
+	if n == nil {
+		return 0
+	}
+
+	lh := checkBalanced(t, n.left)
+	rh := checkBalanced(t, n.right)
+
+	if d := lh - rh; d > 1 || d < -1 {
+		t.Fatalf("node %v unbalanced: left height %d, right height %d", n.key, lh, rh)
+	}
+
+	h := 1 + max(lh, rh)
+	if h != n.height {
+		t.Fatalf("node %v has cached height %d, want %d", n.key, n.height, h)
+	}
+	return h
+}
+
+func assertSortedKeys(t *testing.T, m *SortedMap[int, int], want []int) {
+	t.Helper()
+
+	var got []int
+	for k := range m.Keys() {
+		got = append(got, k)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+	for i, k := range want {
+		if got[i] != k {
+			t.Fatalf("keys = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortedMapRotateLL(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{3, 2, 1} {
+		m.Set(k, k)
+	}
+	checkBalanced(t, m.root)
+	assertSortedKeys(t, m, []int{1, 2, 3})
+}
+
+func TestSortedMapRotateRR(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{1, 2, 3} {
+		m.Set(k, k)
+	}
+	checkBalanced(t, m.root)
+	assertSortedKeys(t, m, []int{1, 2, 3})
+}
+
+func TestSortedMapRotateLR(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{3, 1, 2} {
+		m.Set(k, k)
+	}
+	checkBalanced(t, m.root)
+	assertSortedKeys(t, m, []int{1, 2, 3})
+}
+
+func TestSortedMapRotateRL(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{1, 3, 2} {
+		m.Set(k, k)
+	}
+	checkBalanced(t, m.root)
+	assertSortedKeys(t, m, []int{1, 2, 3})
+}
+
+func TestSortedMapInsertDeleteStaysBalanced(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for i := 0; i < 200; i++ {
+		m.Set(i, i)
+	}
+	checkBalanced(t, m.root)
+
+	for i := 0; i < 200; i += 2 {
+		m.Delete(i)
+	}
+	checkBalanced(t, m.root)
+
+	if m.Len() != 100 {
+		t.Fatalf("Len() = %v, want 100", m.Len())
+	}
+
+	want := make([]int, 0, 100)
+	for i := 1; i < 200; i += 2 {
+		want = append(want, i)
+	}
+	assertSortedKeys(t, m, want)
+}
+
+func TestSortedMapDeleteTwoChildren(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{4, 2, 6, 1, 3, 5, 7} {
+		m.Set(k, k)
+	}
+
+	m.Delete(4) // root has two children, replaced by its in-order successor
+	checkBalanced(t, m.root)
+	assertSortedKeys(t, m, []int{1, 2, 3, 5, 6, 7})
+
+	if _, ok := m.Lookup(4); ok {
+		t.Fatalf("4 should have been deleted")
+	}
+}
+
+func TestSortedMapBackward(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		m.Set(k, k)
+	}
+
+	var got []int
+	for k := range m.Backward() {
+		got = append(got, k)
+	}
+	want := []int{8, 5, 4, 3, 1}
+	if len(got) != len(want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+	for i, k := range want {
+		if got[i] != k {
+			t.Fatalf("keys = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortedMapRangeBounds(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{1, 2, 3, 4, 5} {
+		m.Set(k, k)
+	}
+
+	collect := func(lo, hi int) []int {
+		var got []int
+		for k := range m.Range(lo, hi) {
+			got = append(got, k)
+		}
+		return got
+	}
+	assertRange := func(lo, hi int, want []int) {
+		t.Helper()
+		got := collect(lo, hi)
+		if len(got) != len(want) {
+			t.Fatalf("Range(%v, %v) = %v, want %v", lo, hi, got, want)
+		}
+		for i, k := range want {
+			if got[i] != k {
+				t.Fatalf("Range(%v, %v) = %v, want %v", lo, hi, got, want)
+			}
+		}
+	}
+
+	assertRange(2, 4, []int{2, 3})
+	assertRange(3, 3, nil)                     // lo == hi: empty
+	assertRange(-10, -5, nil)                  // entirely below the tree
+	assertRange(10, 20, nil)                   // entirely above the tree
+	assertRange(-10, 20, []int{1, 2, 3, 4, 5}) // covers everything
+}
+
+func TestSortedMapRangeEmptyMap(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for range m.Range(0, 10) {
+		t.Fatalf("Range on an empty map should yield nothing")
+	}
+}
+
+func TestSortedMapMinMax(t *testing.T) {
+	m := NewSortedMap[int, string]()
+	if _, _, ok := m.Min(); ok {
+		t.Fatalf("Min() on empty map should report ok=false")
+	}
+
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		m.Set(k, "v")
+	}
+
+	if min, _, ok := m.Min(); !ok || min != 1 {
+		t.Fatalf("Min() = %v, %v, want 1, true", min, ok)
+	}
+	if max, _, ok := m.Max(); !ok || max != 8 {
+		t.Fatalf("Max() = %v, %v, want 8, true", max, ok)
+	}
+}
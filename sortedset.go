@@ -0,0 +1,95 @@
+package xcontainer
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedSet is a set of unique values kept in sorted order. It is
+// implemented on top of [SortedMap].
+//
+// Unlike a built-in Go map, a zero-value SortedSet is not valid. Use
+// [NewSortedSet] or [NewSortedSetFunc] to create one.
+type SortedSet[K any] struct {
+	m *SortedMap[K, struct{}]
+}
+
+// NewSortedSet returns a new, empty SortedSet ordered using
+// [cmp.Compare].
+func NewSortedSet[K cmp.Ordered]() *SortedSet[K] {
+	return &SortedSet[K]{m: NewSortedMap[K, struct{}]()}
+}
+
+// NewSortedSetFunc returns a new, empty SortedSet ordered using cmp,
+// with the same semantics as [NewSortedMapFunc].
+func NewSortedSetFunc[K any](cmp func(K, K) int) *SortedSet[K] {
+	return &SortedSet[K]{m: NewSortedMapFunc[K, struct{}](cmp)}
+}
+
+// Set adds key to s.
+func (s *SortedSet[K]) Set(key K) {
+	s.m.Set(key, struct{}{})
+}
+
+// Lookup returns whether or not key is in s.
+func (s *SortedSet[K]) Lookup(key K) bool {
+	_, ok := s.m.Lookup(key)
+	return ok
+}
+
+// Delete removes key from s. If key is not in s, it does nothing.
+func (s *SortedSet[K]) Delete(key K) {
+	s.m.Delete(key)
+}
+
+// Clear deletes everything from s, resulting in an empty set.
+func (s *SortedSet[K]) Clear() {
+	s.m.Clear()
+}
+
+// Len returns the number of elements in s.
+func (s *SortedSet[K]) Len() int {
+	return s.m.Len()
+}
+
+// Min returns the smallest key in s, along with a boolean indicating
+// whether or not s is non-empty.
+func (s *SortedSet[K]) Min() (key K, ok bool) {
+	key, _, ok = s.m.Min()
+	return key, ok
+}
+
+// Max returns the largest key in s, along with a boolean indicating
+// whether or not s is non-empty.
+func (s *SortedSet[K]) Max() (key K, ok bool) {
+	key, _, ok = s.m.Max()
+	return key, ok
+}
+
+// All returns an iter.Seq that yields keys in ascending order.
+func (s *SortedSet[K]) All() iter.Seq[K] {
+	return s.m.Keys()
+}
+
+// Backward returns an iter.Seq that yields keys in descending order.
+func (s *SortedSet[K]) Backward() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.m.Backward() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iter.Seq that yields keys k such that
+// lo <= k < hi, in ascending order.
+func (s *SortedSet[K]) Range(lo, hi K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.m.Range(lo, hi) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package xcontainer
+
+import "testing"
+
+func assertSortedSetKeys(t *testing.T, got, want []int) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+	for i, k := range want {
+		if got[i] != k {
+			t.Fatalf("keys = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortedSetBasics(t *testing.T) {
+	s := NewSortedSet[int]()
+	if s.Lookup(1) {
+		t.Fatalf("Lookup(1) on empty set = true, want false")
+	}
+
+	s.Set(3)
+	s.Set(1)
+	s.Set(2)
+	s.Set(2) // duplicate, should not increase Len
+
+	if s.Len() != 3 {
+		t.Fatalf("Len() = %v, want 3", s.Len())
+	}
+	if !s.Lookup(2) {
+		t.Fatalf("Lookup(2) = false, want true")
+	}
+
+	s.Delete(2)
+	if s.Lookup(2) {
+		t.Fatalf("2 should have been deleted")
+	}
+	if s.Len() != 2 {
+		t.Fatalf("Len() = %v, want 2", s.Len())
+	}
+}
+
+func TestSortedSetRangeAndBackward(t *testing.T) {
+	s := NewSortedSet[int]()
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		s.Set(k)
+	}
+
+	var all []int
+	for k := range s.All() {
+		all = append(all, k)
+	}
+	assertSortedSetKeys(t, all, []int{1, 3, 4, 5, 8})
+
+	var backward []int
+	for k := range s.Backward() {
+		backward = append(backward, k)
+	}
+	assertSortedSetKeys(t, backward, []int{8, 5, 4, 3, 1})
+
+	var ranged []int
+	for k := range s.Range(3, 8) {
+		ranged = append(ranged, k)
+	}
+	assertSortedSetKeys(t, ranged, []int{3, 4, 5})
+}
+
+func TestSortedSetMinMax(t *testing.T) {
+	s := NewSortedSet[int]()
+	if _, ok := s.Min(); ok {
+		t.Fatalf("Min() on empty set should report ok=false")
+	}
+	if _, ok := s.Max(); ok {
+		t.Fatalf("Max() on empty set should report ok=false")
+	}
+
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		s.Set(k)
+	}
+	if min, ok := s.Min(); !ok || min != 1 {
+		t.Fatalf("Min() = %v, %v, want 1, true", min, ok)
+	}
+	if max, ok := s.Max(); !ok || max != 8 {
+		t.Fatalf("Max() = %v, %v, want 8, true", max, ok)
+	}
+}
+
+func TestSortedSetFunc(t *testing.T) {
+	s := NewSortedSetFunc(func(a, b int) int { return b - a }) // reverse order
+	s.Set(1)
+	s.Set(2)
+	s.Set(3)
+
+	var got []int
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	assertSortedSetKeys(t, got, []int{3, 2, 1})
+}
+
+func TestSortedSetClear(t *testing.T) {
+	s := NewSortedSet[int]()
+	s.Set(1)
+	s.Set(2)
+	s.Clear()
+
+	if s.Len() != 0 {
+		t.Fatalf("Len() = %v, want 0 after Clear", s.Len())
+	}
+	if s.Lookup(1) {
+		t.Fatalf("Lookup(1) after Clear = true, want false")
+	}
+}
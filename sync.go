@@ -0,0 +1,107 @@
+package xcontainer
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncOrderedMap wraps an [OrderedMap] behind a [sync.RWMutex],
+// making it safe for concurrent use. Its iteration methods operate on
+// a snapshot taken at call time, so the caller can safely range over
+// the result without holding any lock.
+//
+// Like [OrderedMap], a zero-value SyncOrderedMap is empty and ready to
+// use.
+type SyncOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  OrderedMap[K, V]
+}
+
+// Set sets the provided key to val in m.
+func (m *SyncOrderedMap[K, V]) Set(key K, val V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Set(key, val)
+}
+
+// Lookup returns the value associated with the key and a boolean
+// indicating if there was one or not.
+func (m *SyncOrderedMap[K, V]) Lookup(key K) (val V, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Lookup(key)
+}
+
+// Delete removes the value associated with key from the map. If no
+// such value exists, it does nothing.
+func (m *SyncOrderedMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Delete(key)
+}
+
+// Clear deletes everything from m, resulting in an empty map.
+func (m *SyncOrderedMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Clear()
+}
+
+// Len returns the number of entries in m.
+func (m *SyncOrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Len()
+}
+
+// snapshot returns a copy of m's entries, in insertion order, taken
+// while holding the read lock.
+func (m *SyncOrderedMap[K, V]) snapshot() []Pair[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	pairs := make([]Pair[K, V], 0, m.m.Len())
+	for k, v := range m.m.All() {
+		pairs = append(pairs, Pair[K, V]{Key: k, Val: v})
+	}
+	return pairs
+}
+
+// All returns an iter.Seq2 over a snapshot of m's key value pairs, in
+// insertion order as of the time All was called.
+func (m *SyncOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	pairs := m.snapshot()
+	return func(yield func(K, V) bool) {
+		for _, p := range pairs {
+			if !yield(p.Key, p.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iter.Seq over a snapshot of m's keys, in insertion
+// order as of the time Keys was called.
+func (m *SyncOrderedMap[K, V]) Keys() iter.Seq[K] {
+	pairs := m.snapshot()
+	return func(yield func(K) bool) {
+		for _, p := range pairs {
+			if !yield(p.Key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iter.Seq over a snapshot of m's values, in
+// insertion order as of the time Values was called.
+func (m *SyncOrderedMap[K, V]) Values() iter.Seq[V] {
+	pairs := m.snapshot()
+	return func(yield func(V) bool) {
+		for _, p := range pairs {
+			if !yield(p.Val) {
+				return
+			}
+		}
+	}
+}